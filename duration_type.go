@@ -0,0 +1,127 @@
+package whenwords
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigDuration is a time.Duration that marshals to and from human-written
+// duration strings (e.g. "2h 30m", "90 days") instead of raw nanoseconds, so
+// it can be embedded directly in JSON/YAML config structs. It implements
+// encoding.TextMarshaler/TextUnmarshaler, and the corresponding JSON and YAML
+// marshal interfaces route through the same text representation.
+//
+// It is named ConfigDuration rather than Duration to avoid colliding with
+// the existing Duration formatting function.
+type ConfigDuration struct {
+	d time.Duration
+}
+
+// NewConfigDuration wraps a time.Duration as a ConfigDuration.
+func NewConfigDuration(d time.Duration) ConfigDuration {
+	return ConfigDuration{d: d}
+}
+
+// Duration returns the underlying time.Duration.
+func (c ConfigDuration) Duration() time.Duration {
+	return c.d
+}
+
+// String returns the compact "Duration(...)" representation.
+func (c ConfigDuration) String() string {
+	return "Duration(" + c.d.String() + ")"
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c ConfigDuration) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts anything
+// ParseDuration accepts ("2h 30m", "90 days"), the compact "Duration(2h30m)"
+// form produced by MarshalText, and bare integers (interpreted as seconds).
+func (c *ConfigDuration) UnmarshalText(text []byte) error {
+	d, err := parseConfigDuration(string(text))
+	if err != nil {
+		return err
+	}
+	c.d = d
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c ConfigDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts a human-written
+// string ("2h 30m 15s") or a bare JSON integer, interpreted as seconds; a
+// fractional number is rejected.
+func (c *ConfigDuration) UnmarshalJSON(data []byte) error {
+	var n int64
+	if err := json.Unmarshal(data, &n); err == nil {
+		if n < 0 {
+			return ErrNegativeValue
+		}
+		c.d = time.Duration(n) * time.Second
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return c.UnmarshalText([]byte(s))
+}
+
+// MarshalYAML implements yaml.Marshaler (gopkg.in/yaml.v3).
+func (c ConfigDuration) MarshalYAML() (interface{}, error) {
+	return c.String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler (gopkg.in/yaml.v3). It accepts
+// the same forms as UnmarshalJSON: a human-written string or a bare integer
+// scalar, interpreted as seconds.
+func (c *ConfigDuration) UnmarshalYAML(value *yaml.Node) error {
+	var n int64
+	if err := value.Decode(&n); err == nil {
+		if n < 0 {
+			return ErrNegativeValue
+		}
+		c.d = time.Duration(n) * time.Second
+		return nil
+	}
+
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return err
+	}
+	return c.UnmarshalText([]byte(s))
+}
+
+// parseConfigDuration parses the forms accepted by ConfigDuration: a bare
+// integer (seconds), the compact "Duration(...)" form, or anything
+// ParseDuration accepts.
+func parseConfigDuration(input string) (time.Duration, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return 0, ErrEmptyInput
+	}
+
+	if strings.HasPrefix(input, "Duration(") && strings.HasSuffix(input, ")") {
+		input = strings.TrimSuffix(strings.TrimPrefix(input, "Duration("), ")")
+	}
+
+	if n, err := strconv.ParseInt(input, 10, 64); err == nil {
+		if n < 0 {
+			return 0, ErrNegativeValue
+		}
+		return time.Duration(n) * time.Second, nil
+	}
+
+	return ParseDurationPrecise(input)
+}
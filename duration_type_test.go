@@ -0,0 +1,103 @@
+package whenwords
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TestConfigDurationJSONRoundTrip covers ConfigDuration's JSON happy paths:
+// a human-written string, a positive bare integer (seconds), and marshaling
+// back out to its compact "Duration(...)" form.
+func TestConfigDurationJSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  time.Duration
+	}{
+		{"human_written", `"2h 30m"`, 2*time.Hour + 30*time.Minute},
+		{"days_word", `"90 days"`, 90 * secondsPerDay * time.Second},
+		{"bare_integer_seconds", `300`, 300 * time.Second},
+		{"compact_form", `"Duration(2h30m0s)"`, 2*time.Hour + 30*time.Minute},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var c ConfigDuration
+			if err := json.Unmarshal([]byte(tc.input), &c); err != nil {
+				t.Fatalf("UnmarshalJSON(%s) returned error: %v", tc.input, err)
+			}
+			if c.Duration() != tc.want {
+				t.Errorf("UnmarshalJSON(%s) = %v, want %v", tc.input, c.Duration(), tc.want)
+			}
+		})
+	}
+
+	c := NewConfigDuration(2*time.Hour + 30*time.Minute)
+	data, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("MarshalJSON returned error: %v", err)
+	}
+	if want := `"Duration(2h30m0s)"`; string(data) != want {
+		t.Errorf("MarshalJSON = %s, want %s", data, want)
+	}
+}
+
+// TestConfigDurationYAMLRoundTrip covers the same happy paths as
+// TestConfigDurationJSONRoundTrip through YAML instead of JSON.
+func TestConfigDurationYAMLRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  time.Duration
+	}{
+		{"human_written", `2h 30m`, 2*time.Hour + 30*time.Minute},
+		{"days_word", `90 days`, 90 * secondsPerDay * time.Second},
+		{"bare_integer_seconds", `300`, 300 * time.Second},
+		{"compact_form", `Duration(2h30m0s)`, 2*time.Hour + 30*time.Minute},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var c ConfigDuration
+			if err := yaml.Unmarshal([]byte(tc.input), &c); err != nil {
+				t.Fatalf("UnmarshalYAML(%s) returned error: %v", tc.input, err)
+			}
+			if c.Duration() != tc.want {
+				t.Errorf("UnmarshalYAML(%s) = %v, want %v", tc.input, c.Duration(), tc.want)
+			}
+		})
+	}
+
+	c := NewConfigDuration(2*time.Hour + 30*time.Minute)
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		t.Fatalf("MarshalYAML returned error: %v", err)
+	}
+	if want := "Duration(2h30m0s)\n"; string(data) != want {
+		t.Errorf("MarshalYAML = %q, want %q", data, want)
+	}
+}
+
+// TestConfigDurationUnmarshalJSONNegative verifies a negative bare JSON
+// integer is rejected the same way a negative text form is.
+func TestConfigDurationUnmarshalJSONNegative(t *testing.T) {
+	var c ConfigDuration
+	err := json.Unmarshal([]byte(`-5`), &c)
+	if !errors.Is(err, ErrNegativeValue) {
+		t.Errorf("UnmarshalJSON(-5) error = %v, want %v", err, ErrNegativeValue)
+	}
+}
+
+// TestConfigDurationUnmarshalYAMLNegative verifies a negative bare YAML
+// integer scalar is rejected the same way a negative text form is.
+func TestConfigDurationUnmarshalYAMLNegative(t *testing.T) {
+	var c ConfigDuration
+	err := yaml.Unmarshal([]byte(`-5`), &c)
+	if !errors.Is(err, ErrNegativeValue) {
+		t.Errorf("UnmarshalYAML(-5) error = %v, want %v", err, ErrNegativeValue)
+	}
+}
@@ -0,0 +1,200 @@
+package whenwords
+
+import (
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// PluralForm holds a locale's singular and plural word for one duration
+// unit, e.g. {"minute", "minutes"} (English) or {"minuut", "minuten"}
+// (Dutch).
+type PluralForm struct {
+	Singular string
+	Plural   string
+}
+
+// Locale supplies the message templates, weekday names, pluralization, and
+// duration vocabulary that TimeAgo, Duration, HumanDate, and
+// ParseDurationLocale use to localize their output. Select one with
+// WithLocale, or register a new one with RegisterLocale.
+type Locale struct {
+	// Name is the locale's registry key, e.g. "en" or "nl".
+	Name string
+
+	// JustNow is TimeAgo's output for very recent timestamps.
+	JustNow string
+	// AgoSuffix is appended after the formatted quantity for past
+	// timestamps, e.g. " ago" (English) or " geleden" (Dutch).
+	AgoSuffix string
+	// InPrefix is prepended before the formatted quantity for future
+	// timestamps, e.g. "in " (English) or "over " (Dutch).
+	InPrefix string
+
+	// Today, Yesterday, and Tomorrow are HumanDate's near-term labels.
+	Today     string
+	Yesterday string
+	Tomorrow  string
+	// LastPrefix and ThisPrefix are prepended to a localized weekday name,
+	// e.g. "Last " + "Monday" or "Vorige " + "maandag".
+	LastPrefix string
+	ThisPrefix string
+
+	// Weekdays maps each time.Weekday to its localized name.
+	Weekdays map[time.Weekday]string
+
+	// Units maps the canonical unit key ("second", "minute", "hour",
+	// "day", "month", "year") to this locale's word forms.
+	Units map[string]PluralForm
+
+	// UnitWords maps this locale's own duration vocabulary (e.g.
+	// "minuten") to the canonical unit key, so ParseDurationLocale can
+	// resolve localized input.
+	UnitWords map[string]string
+}
+
+// Plural returns the localized word for n of the given canonical unit key,
+// falling back to the key itself if the locale doesn't define it.
+func (l Locale) Plural(n int, unitKey string) string {
+	form, ok := l.Units[unitKey]
+	if !ok {
+		return unitKey
+	}
+	if n == 1 {
+		return form.Singular
+	}
+	return form.Plural
+}
+
+// Weekday returns the locale's name for day, falling back to Go's default
+// English name if the locale doesn't define it.
+func (l Locale) Weekday(day time.Weekday) string {
+	if name, ok := l.Weekdays[day]; ok {
+		return name
+	}
+	return day.String()
+}
+
+// LocaleEN is the default English locale, matching whenwords' original
+// (pre-i18n) output.
+var LocaleEN = Locale{
+	Name: "en",
+
+	JustNow:   "just now",
+	AgoSuffix: " ago",
+	InPrefix:  "in ",
+
+	Today:      "Today",
+	Yesterday:  "Yesterday",
+	Tomorrow:   "Tomorrow",
+	LastPrefix: "Last ",
+	ThisPrefix: "This ",
+
+	Weekdays: map[time.Weekday]string{
+		time.Sunday:    "Sunday",
+		time.Monday:    "Monday",
+		time.Tuesday:   "Tuesday",
+		time.Wednesday: "Wednesday",
+		time.Thursday:  "Thursday",
+		time.Friday:    "Friday",
+		time.Saturday:  "Saturday",
+	},
+
+	Units: map[string]PluralForm{
+		"second": {"second", "seconds"},
+		"minute": {"minute", "minutes"},
+		"hour":   {"hour", "hours"},
+		"day":    {"day", "days"},
+		"month":  {"month", "months"},
+		"year":   {"year", "years"},
+	},
+
+	UnitWords: map[string]string{
+		"second": "second", "seconds": "second", "sec": "second", "secs": "second",
+		"minute": "minute", "minutes": "minute", "min": "minute", "mins": "minute",
+		"hour": "hour", "hours": "hour", "hr": "hour", "hrs": "hour",
+		"day": "day", "days": "day",
+		"week": "week", "weeks": "week",
+		"month": "month", "months": "month",
+		"year": "year", "years": "year",
+	},
+}
+
+// LocaleNL is a Dutch locale, included to prove the Locale abstraction
+// generalizes beyond English: correct plural forms ("1 minuut" vs "2
+// minuten"), localized weekday names, and localized duration vocabulary.
+var LocaleNL = Locale{
+	Name: "nl",
+
+	JustNow:   "zojuist",
+	AgoSuffix: " geleden",
+	InPrefix:  "over ",
+
+	Today:      "Vandaag",
+	Yesterday:  "Gisteren",
+	Tomorrow:   "Morgen",
+	LastPrefix: "Vorige ",
+	ThisPrefix: "Deze ",
+
+	Weekdays: map[time.Weekday]string{
+		time.Sunday:    "zondag",
+		time.Monday:    "maandag",
+		time.Tuesday:   "dinsdag",
+		time.Wednesday: "woensdag",
+		time.Thursday:  "donderdag",
+		time.Friday:    "vrijdag",
+		time.Saturday:  "zaterdag",
+	},
+
+	Units: map[string]PluralForm{
+		"second": {"seconde", "seconden"},
+		"minute": {"minuut", "minuten"},
+		"hour":   {"uur", "uur"},
+		"day":    {"dag", "dagen"},
+		"month":  {"maand", "maanden"},
+		"year":   {"jaar", "jaar"},
+	},
+
+	UnitWords: map[string]string{
+		"seconde": "second", "seconden": "second",
+		"minuut": "minute", "minuten": "minute",
+		"uur": "hour", "uren": "hour",
+		"dag": "day", "dagen": "day",
+		"maand": "month", "maanden": "month",
+		"jaar": "year", "jaren": "year",
+	},
+}
+
+// localeRegistry holds every locale registered via RegisterLocale, keyed by
+// lowercase name. LocaleEN and LocaleNL are registered by default.
+var localeRegistry = map[string]Locale{}
+
+func init() {
+	RegisterLocale(LocaleEN.Name, LocaleEN)
+	RegisterLocale(LocaleNL.Name, LocaleNL)
+}
+
+// RegisterLocale adds or replaces a locale in the package-wide registry
+// under name (case-insensitive), making it resolvable via LocaleFromTag.
+func RegisterLocale(name string, l Locale) {
+	localeRegistry[strings.ToLower(name)] = l
+}
+
+// LocaleFromTag resolves a language.Tag to a registered Locale by its base
+// language subtag (e.g. "nl" for both "nl" and "nl-BE"), falling back to
+// LocaleEN if nothing is registered for it.
+func LocaleFromTag(tag language.Tag) Locale {
+	base, _ := tag.Base()
+	if l, ok := localeRegistry[base.String()]; ok {
+		return l
+	}
+	return LocaleEN
+}
+
+// ParseDurationLocale is ParseDurationPrecise extended with locale's own
+// duration vocabulary (e.g. Dutch "5 minuten"), so config written in a
+// user's language can be parsed without translating it to English first.
+func ParseDurationLocale(input string, locale Locale) (time.Duration, error) {
+	return parseDurationPreciseLocale(input, &locale)
+}
@@ -0,0 +1,49 @@
+package whenwords
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+// TestParseDurationLocale verifies a locale's own duration vocabulary (e.g.
+// Dutch "minuten") is resolved by ParseDurationLocale.
+func TestParseDurationLocale(t *testing.T) {
+	got, err := ParseDurationLocale("5 minuten", LocaleNL)
+	if err != nil {
+		t.Fatalf("ParseDurationLocale(%q, LocaleNL) returned error: %v", "5 minuten", err)
+	}
+	if want := 5 * secondsPerMinute; got.Seconds() != float64(want) {
+		t.Errorf("ParseDurationLocale(%q, LocaleNL) = %v, want %d seconds", "5 minuten", got, want)
+	}
+}
+
+// TestRegisterLocaleAndLocaleFromTag verifies a custom locale registered via
+// RegisterLocale round-trips through LocaleFromTag by its base language
+// subtag, and that an unregistered tag falls back to LocaleEN.
+func TestRegisterLocaleAndLocaleFromTag(t *testing.T) {
+	custom := Locale{
+		Name:      "de",
+		JustNow:   "gerade eben",
+		AgoSuffix: " her",
+		InPrefix:  "in ",
+	}
+	RegisterLocale(custom.Name, custom)
+
+	got := LocaleFromTag(language.MustParse("de"))
+	if got.Name != custom.Name {
+		t.Errorf("LocaleFromTag(de) = %q, want %q", got.Name, custom.Name)
+	}
+
+	// A regional variant of the registered base subtag resolves the same.
+	got = LocaleFromTag(language.MustParse("nl-BE"))
+	if got.Name != LocaleNL.Name {
+		t.Errorf("LocaleFromTag(nl-BE) = %q, want %q", got.Name, LocaleNL.Name)
+	}
+
+	// An unregistered tag falls back to LocaleEN.
+	got = LocaleFromTag(language.MustParse("ja"))
+	if got.Name != LocaleEN.Name {
+		t.Errorf("LocaleFromTag(ja) = %q, want %q (fallback)", got.Name, LocaleEN.Name)
+	}
+}
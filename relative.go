@@ -0,0 +1,55 @@
+package whenwords
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Relative-time direction markers recognized by ParseRelative.
+var (
+	relativeAgoPattern     = regexp.MustCompile(`(?i)\s+ago\s*$`)
+	relativeInPattern      = regexp.MustCompile(`(?i)^\s*in\s+`)
+	relativeFromNowPattern = regexp.MustCompile(`(?i)\s+from\s+now\s*$`)
+)
+
+// ParseRelative parses a relative time expression such as "3 hours ago",
+// "in 2 days", or "5 minutes from now" into an absolute time.Time relative
+// to reference. It is the inverse of TimeAgo for TimeAgo's English
+// "X ago" and "in X" strings, which round-trip through ParseRelative.
+// Input without an "ago", "in", or "from now" marker (including TimeAgo's
+// "just now") returns ErrUnparseable, and localized markers such as Dutch
+// "geleden" are not recognized.
+func ParseRelative(input string, reference time.Time) (time.Time, error) {
+	trimmed := strings.TrimSpace(input)
+	if trimmed == "" {
+		return time.Time{}, ErrEmptyInput
+	}
+
+	var future bool
+	var body string
+
+	switch {
+	case relativeAgoPattern.MatchString(trimmed):
+		future = false
+		body = relativeAgoPattern.ReplaceAllString(trimmed, "")
+	case relativeFromNowPattern.MatchString(trimmed):
+		future = true
+		body = relativeFromNowPattern.ReplaceAllString(trimmed, "")
+	case relativeInPattern.MatchString(trimmed):
+		future = true
+		body = relativeInPattern.ReplaceAllString(trimmed, "")
+	default:
+		return time.Time{}, ErrUnparseable
+	}
+
+	d, found := parseUnitValuePairs(body)
+	if !found {
+		return time.Time{}, ErrUnparseable
+	}
+
+	if !future {
+		return reference.Add(-d), nil
+	}
+	return reference.Add(d), nil
+}
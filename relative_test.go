@@ -0,0 +1,78 @@
+package whenwords
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseRelative covers the "X ago", "in X", and "X from now" forms and
+// checks the result against reference +/- the parsed duration.
+func TestParseRelative(t *testing.T) {
+	reference := time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		input string
+		want  time.Time
+	}{
+		{"ago", "3 hours ago", reference.Add(-3 * time.Hour)},
+		{"in", "in 2 days", reference.Add(2 * 24 * time.Hour)},
+		{"from_now", "5 minutes from now", reference.Add(5 * time.Minute)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseRelative(tc.input, reference)
+			if err != nil {
+				t.Fatalf("ParseRelative(%q) returned error: %v", tc.input, err)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("ParseRelative(%q) = %v, want %v", tc.input, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestParseRelativeRoundTrip verifies ParseRelative is the inverse of
+// TimeAgo: feeding TimeAgo's own output back into ParseRelative recovers a
+// timestamp TimeAgo would describe the same way.
+func TestParseRelativeRoundTrip(t *testing.T) {
+	reference := time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+	timestamp := reference.Add(-2 * time.Hour).Unix()
+
+	phrase := TimeAgo(timestamp, WithReference(reference.Unix()))
+
+	got, err := ParseRelative(phrase, reference)
+	if err != nil {
+		t.Fatalf("ParseRelative(%q) returned error: %v", phrase, err)
+	}
+
+	roundTripped := TimeAgo(got.Unix(), WithReference(reference.Unix()))
+	if roundTripped != phrase {
+		t.Errorf("round-trip through ParseRelative changed TimeAgo output: %q -> %q", phrase, roundTripped)
+	}
+}
+
+// TestParseRelativeErrors covers the empty-input and no-direction-marker
+// error paths.
+func TestParseRelativeErrors(t *testing.T) {
+	reference := time.Unix(0, 0)
+
+	tests := []struct {
+		name  string
+		input string
+		want  error
+	}{
+		{"empty", "", ErrEmptyInput},
+		{"no_direction_marker", "3 hours", ErrUnparseable},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseRelative(tc.input, reference)
+			if err != tc.want {
+				t.Errorf("ParseRelative(%q) error = %v, want %v", tc.input, err, tc.want)
+			}
+		})
+	}
+}
@@ -17,35 +17,130 @@ var (
 	ErrNegativeValue    = errors.New("negative values are not allowed")
 )
 
+// Calendar unit lengths shared across formatting and parsing. Months and
+// years are approximated as fixed-length (30-day months, 365-day years)
+// since whenwords has no calendar/timezone context to compute exact
+// calendar arithmetic from a bare duration.
+const (
+	secondsPerMinute = 60
+	secondsPerHour   = 3600
+	secondsPerDay    = 86400
+	secondsPerMonth  = 30 * secondsPerDay
+	secondsPerYear   = 365 * secondsPerDay
+)
+
+// Option configures whenwords' locale-aware formatting functions. TimeAgo,
+// Duration, and HumanDate each expose it under their own named alias
+// (TimeAgoOption, DurationOption, HumanDateOption) for readability at call
+// sites; the underlying type is shared so a single WithLocale/WithReference
+// constructor works across all three instead of three near-identical ones.
+type Option func(*config)
+
+// TimeAgoOption configures TimeAgo output.
+type TimeAgoOption = Option
+
 // DurationOption configures Duration output.
-type DurationOption func(*durationConfig)
+type DurationOption = Option
+
+// HumanDateOption configures HumanDate output.
+type HumanDateOption = Option
+
+// config holds the options for whenwords' formatting functions. Not every
+// field applies to every function; each option constructor documents which
+// function(s) read it.
+type config struct {
+	compact  bool // Duration
+	maxUnits int  // Duration
 
-// durationConfig holds options for Duration formatting.
-type durationConfig struct {
-	compact  bool
-	maxUnits int
+	reference    int64 // TimeAgo, HumanDate
+	hasReference bool  // TimeAgo, HumanDate
+
+	location   *time.Location // HumanDate
+	weekStart  time.Weekday   // HumanDate
+	dateFormat string         // HumanDate
+
+	locale Locale // TimeAgo, Duration, HumanDate
 }
 
 // WithCompact enables compact output format (e.g., "2h 30m" instead of "2 hours, 30 minutes").
 func WithCompact() DurationOption {
-	return func(c *durationConfig) {
+	return func(c *config) {
 		c.compact = true
 	}
 }
 
 // WithMaxUnits limits the number of time units in the output.
 func WithMaxUnits(n int) DurationOption {
-	return func(c *durationConfig) {
+	return func(c *config) {
 		c.maxUnits = n
 	}
 }
 
+// WithReference sets the comparison timestamp: for TimeAgo, the "now" to
+// measure the timestamp against; for HumanDate, the date "Today"/"Last
+// Monday"/etc. are relative to. It replaces the former bare
+// `reference ...int64` parameter on both functions. Without it, the
+// timestamp is compared against itself (TimeAgo returns "just now";
+// HumanDate returns its Today label).
+func WithReference(timestamp int64) Option {
+	return func(c *config) {
+		c.reference = timestamp
+		c.hasReference = true
+	}
+}
+
+// WithLocale selects the Locale used to format output, for TimeAgo,
+// Duration, and HumanDate alike. The default is LocaleEN.
+func WithLocale(l Locale) Option {
+	return func(c *config) {
+		c.locale = l
+	}
+}
+
+// WithLocation sets the time.Location HumanDate compares calendar days in.
+// The default is UTC, so "Today"/"Yesterday" don't depend on the host
+// machine's timezone; pass WithLocation(time.Local) (or a specific zone)
+// to classify days the way a user in that zone would see them.
+func WithLocation(loc *time.Location) HumanDateOption {
+	return func(c *config) {
+		c.location = loc
+	}
+}
+
+// WithWeekStart sets the day HumanDate considers the start of the calendar
+// week, which decides the pivot between "This <Weekday>" and
+// "Last <Weekday>". The default is time.Monday.
+func WithWeekStart(day time.Weekday) HumanDateOption {
+	return func(c *config) {
+		c.weekStart = day
+	}
+}
+
+// WithDateFormat overrides HumanDate's fallback Go layout string (normally
+// "January 2" for dates within the reference year, "January 2, 2006"
+// otherwise) with a single layout used for both cases.
+func WithDateFormat(layout string) HumanDateOption {
+	return func(c *config) {
+		c.dateFormat = layout
+	}
+}
+
 // TimeAgo returns a human-readable relative time string.
-// The optional reference parameter defaults to the timestamp itself (returns "just now").
-func TimeAgo(timestamp int64, reference ...int64) string {
+// The optional WithReference defaults to the timestamp itself (returns "just now").
+//
+// BREAKING: TimeAgo previously took its reference as a bare trailing
+// "reference ...int64" argument; callers must now pass WithReference(ts)
+// instead. See CHANGELOG.md.
+func TimeAgo(timestamp int64, opts ...TimeAgoOption) string {
+	cfg := &config{locale: LocaleEN}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	locale := cfg.locale
+
 	ref := timestamp
-	if len(reference) > 0 {
-		ref = reference[0]
+	if cfg.hasReference {
+		ref = cfg.reference
 	}
 
 	diff := ref - timestamp
@@ -54,73 +149,61 @@ func TimeAgo(timestamp int64, reference ...int64) string {
 		diff = -diff
 	}
 
-	// Thresholds in seconds
-	const (
-		secondsPerMinute = 60
-		secondsPerHour   = 3600
-		secondsPerDay    = 86400
-		daysPerMonth     = 30
-		daysPerYear      = 365
-	)
-
 	var n int
-	var unit string
+	var unitKey string
 
 	switch {
 	case diff < 45:
-		return "just now"
+		return locale.JustNow
 
 	case diff < 90:
 		n = 1
-		unit = "minute"
+		unitKey = "minute"
 
 	case diff < 45*secondsPerMinute:
 		n = roundHalfUp(float64(diff) / float64(secondsPerMinute))
-		unit = "minute"
+		unitKey = "minute"
 
 	case diff < 90*secondsPerMinute:
 		n = 1
-		unit = "hour"
+		unitKey = "hour"
 
 	case diff < 22*secondsPerHour:
 		n = roundHalfUp(float64(diff) / float64(secondsPerHour))
-		unit = "hour"
+		unitKey = "hour"
 
 	case diff < 36*secondsPerHour:
 		n = 1
-		unit = "day"
+		unitKey = "day"
 
 	case diff < 26*secondsPerDay:
 		n = roundHalfUp(float64(diff) / float64(secondsPerDay))
-		unit = "day"
+		unitKey = "day"
 
 	case diff < 46*secondsPerDay:
 		n = 1
-		unit = "month"
+		unitKey = "month"
 
 	case diff < 320*secondsPerDay:
 		// Use ~30.44 days per month (365/12) for calculation
 		n = roundHalfUp(float64(diff) / (365.0 / 12.0 * float64(secondsPerDay)))
-		unit = "month"
+		unitKey = "month"
 
 	case diff < 548*secondsPerDay:
 		n = 1
-		unit = "year"
+		unitKey = "year"
 
 	default:
-		n = roundHalfUp(float64(diff) / float64(daysPerYear*secondsPerDay))
-		unit = "year"
+		n = roundHalfUp(float64(diff) / float64(secondsPerYear))
+		unitKey = "year"
 	}
 
-	// Pluralize
-	if n != 1 {
-		unit += "s"
-	}
+	quantity := itoa(n) + " " + locale.Plural(n, unitKey)
 
 	if future {
-		return "in " + itoa(n) + " " + unit
+		return locale.InPrefix + quantity
 	}
-	return itoa(n) + " " + unit + " ago"
+	return quantity + locale.AgoSuffix
 }
 
 // roundHalfUp rounds to nearest integer with half-up rounding (2.5 -> 3).
@@ -153,25 +236,18 @@ func Duration(seconds int64, opts ...DurationOption) (string, error) {
 		return "", ErrNegativeDuration
 	}
 
-	cfg := &durationConfig{maxUnits: 2}
+	cfg := &config{maxUnits: 2, locale: LocaleEN}
 	for _, opt := range opts {
 		opt(cfg)
 	}
+	locale := cfg.locale
 
-	// Time unit constants
-	const (
-		secondsPerMinute = 60
-		secondsPerHour   = 3600
-		secondsPerDay    = 86400
-		secondsPerMonth  = 30 * secondsPerDay
-		secondsPerYear   = 365 * secondsPerDay
-	)
-
-	// Unit names for compact and verbose output
+	// Unit names for compact output and the canonical unit key used to
+	// look up the locale's verbose word form.
 	type unit struct {
 		seconds int64
 		compact string
-		verbose string
+		unitKey string
 	}
 	units := []unit{
 		{secondsPerYear, "y", "year"},
@@ -186,7 +262,7 @@ func Duration(seconds int64, opts ...DurationOption) (string, error) {
 	type part struct {
 		value   int64
 		compact string
-		verbose string
+		unitKey string
 	}
 	var parts []part
 	remaining := seconds
@@ -195,7 +271,7 @@ func Duration(seconds int64, opts ...DurationOption) (string, error) {
 		if remaining >= u.seconds {
 			count := remaining / u.seconds
 			remaining %= u.seconds
-			parts = append(parts, part{count, u.compact, u.verbose})
+			parts = append(parts, part{count, u.compact, u.unitKey})
 		}
 	}
 
@@ -204,7 +280,7 @@ func Duration(seconds int64, opts ...DurationOption) (string, error) {
 		if cfg.compact {
 			return "0s", nil
 		}
-		return "0 seconds", nil
+		return "0 " + locale.Plural(0, "second"), nil
 	}
 
 	// Apply max_units limit
@@ -226,18 +302,33 @@ func Duration(seconds int64, opts ...DurationOption) (string, error) {
 		if cfg.compact {
 			result += itoa(int(p.value)) + p.compact
 		} else {
-			result += itoa(int(p.value)) + " " + p.verbose
-			if p.value != 1 {
-				result += "s"
-			}
+			result += itoa(int(p.value)) + " " + locale.Plural(int(p.value), p.unitKey)
 		}
 	}
 
 	return result, nil
 }
 
-// ParseDuration parses a human-written duration string into seconds.
+// ParseDuration parses a human-written duration string into seconds. For
+// sub-second precision, use ParseDurationPrecise.
 func ParseDuration(input string) (int64, error) {
+	d, err := ParseDurationPrecise(input)
+	if err != nil {
+		return 0, err
+	}
+	return int64(d / time.Second), nil
+}
+
+// ParseDurationPrecise parses a human-written duration string into a
+// time.Duration, preserving sub-second precision (e.g. "1500ms").
+func ParseDurationPrecise(input string) (time.Duration, error) {
+	return parseDurationPreciseLocale(input, nil)
+}
+
+// parseDurationPreciseLocale is ParseDurationPrecise and ParseDurationLocale's
+// shared implementation, differing only in whether unit tokens also fall
+// back to a locale's own vocabulary (see parseUnitValuePairsLocale).
+func parseDurationPreciseLocale(input string, locale *Locale) (time.Duration, error) {
 	// Handle empty input
 	input = strings.TrimSpace(input)
 	if input == "" {
@@ -251,16 +342,16 @@ func ParseDuration(input string) (int64, error) {
 
 	// Try colon notation first (h:mm or h:mm:ss)
 	if seconds, ok := parseColonNotation(input); ok {
-		return seconds, nil
+		return time.Duration(seconds) * time.Second, nil
 	}
 
 	// Tokenize with regex for unit-value pairs
-	seconds, found := parseUnitValuePairs(input)
+	d, found := parseUnitValuePairsLocale(input, locale)
 	if !found {
 		return 0, ErrUnparseable
 	}
 
-	return seconds, nil
+	return d, nil
 }
 
 // parseColonNotation parses h:mm or h:mm:ss format.
@@ -282,97 +373,177 @@ func parseColonNotation(input string) (int64, bool) {
 	return hours*3600 + minutes*60 + seconds, true
 }
 
-// parseUnitValuePairs extracts value-unit pairs and sums them.
-func parseUnitValuePairs(input string) (int64, bool) {
-	// Unit multipliers in seconds
-	unitMultipliers := map[string]int64{
-		"w":       604800, // week
-		"week":    604800,
-		"weeks":   604800,
-		"d":       86400, // day
-		"day":     86400,
-		"days":    86400,
-		"h":       3600, // hour
-		"hr":      3600,
-		"hrs":     3600,
-		"hour":    3600,
-		"hours":   3600,
-		"m":       60, // minute
-		"min":     60,
-		"mins":    60,
-		"minute":  60,
-		"minutes": 60,
-		"s":       1, // second
-		"sec":     1,
-		"secs":    1,
-		"second":  1,
-		"seconds": 1,
-	}
-
-	// Pattern: number (possibly decimal) followed by unit
-	// Handles: 2h, 2.5h, 2 hours, 2.5 hours
-	pattern := regexp.MustCompile(`(?i)(\d+(?:\.\d+)?)\s*(w|weeks?|d|days?|h|hrs?|hours?|m|mins?|minutes?|s|secs?|seconds?)`)
-
-	matches := pattern.FindAllStringSubmatch(input, -1)
+// unitTokenPattern tokenizes value-unit pairs such as "2h", "2.5 hours" or
+// "90 days", including the unit-glued mixed form "1h30m15s". The unit is
+// captured as a bare run of letters and resolved against
+// unitValueMultipliers (and, for ParseDurationLocale, a Locale's UnitWords)
+// by resolveUnitMultiplier, rather than being baked into the regex itself —
+// that's what lets a locale's own vocabulary (e.g. Dutch "minuten") plug in
+// without touching this pattern.
+var unitTokenPattern = regexp.MustCompile(`(\d+(?:\.\d+)?)\s*([A-Za-zµμ]+)`)
+
+// unitValueMultipliers maps each unit token to its time.Duration value.
+var unitValueMultipliers = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"µs": time.Microsecond,
+	"μs": time.Microsecond,
+	"ms": time.Millisecond,
+
+	"s":       time.Second,
+	"sec":     time.Second,
+	"secs":    time.Second,
+	"second":  time.Second,
+	"seconds": time.Second,
+
+	"m":       time.Minute,
+	"min":     time.Minute,
+	"mins":    time.Minute,
+	"minute":  time.Minute,
+	"minutes": time.Minute,
+
+	"h":     time.Hour,
+	"hr":    time.Hour,
+	"hrs":   time.Hour,
+	"hour":  time.Hour,
+	"hours": time.Hour,
+
+	"d":    secondsPerDay * time.Second,
+	"day":  secondsPerDay * time.Second,
+	"days": secondsPerDay * time.Second,
+
+	"w":     7 * secondsPerDay * time.Second,
+	"week":  7 * secondsPerDay * time.Second,
+	"weeks": 7 * secondsPerDay * time.Second,
+
+	"M":      secondsPerMonth * time.Second,
+	"month":  secondsPerMonth * time.Second,
+	"months": secondsPerMonth * time.Second,
+
+	"y":     secondsPerYear * time.Second,
+	"year":  secondsPerYear * time.Second,
+	"years": secondsPerYear * time.Second,
+}
+
+// parseUnitValuePairs extracts value-unit pairs and sums them into a
+// time.Duration, using whenwords' built-in (English) unit vocabulary.
+func parseUnitValuePairs(input string) (time.Duration, bool) {
+	return parseUnitValuePairsLocale(input, nil)
+}
+
+// parseUnitValuePairsLocale is parseUnitValuePairs extended with an optional
+// Locale whose UnitWords are consulted when the built-in vocabulary doesn't
+// recognize a token, so e.g. "5 minuten" resolves under LocaleNL.
+func parseUnitValuePairsLocale(input string, locale *Locale) (time.Duration, bool) {
+	matches := unitTokenPattern.FindAllStringSubmatch(input, -1)
 	if len(matches) == 0 {
 		return 0, false
 	}
 
-	var total int64
+	var total time.Duration
+	matched := false
 	for _, match := range matches {
 		valueStr := match[1]
-		unit := strings.ToLower(match[2])
-
-		multiplier, ok := unitMultipliers[unit]
+		multiplier, ok := resolveUnitMultiplier(match[2], locale)
 		if !ok {
 			continue
 		}
+		matched = true
 
 		// Handle decimal values
 		if strings.Contains(valueStr, ".") {
 			value, _ := strconv.ParseFloat(valueStr, 64)
-			total += int64(value * float64(multiplier))
+			total += time.Duration(value * float64(multiplier))
 		} else {
 			value, _ := strconv.ParseInt(valueStr, 10, 64)
-			total += value * multiplier
+			total += time.Duration(value) * multiplier
 		}
 	}
 
+	if !matched {
+		return 0, false
+	}
 	return total, true
 }
 
+// resolveUnitMultiplier looks up a captured unit token, first against the
+// built-in vocabulary and then, if given, the locale's own UnitWords. The
+// single-letter M abbreviation must keep its case to stay distinct from m
+// (minute); y doesn't collide with anything and is matched
+// case-insensitively like every other token.
+func resolveUnitMultiplier(rawUnit string, locale *Locale) (time.Duration, bool) {
+	unit := rawUnit
+	if unit != "M" {
+		unit = strings.ToLower(unit)
+	}
+
+	if multiplier, ok := unitValueMultipliers[unit]; ok {
+		return multiplier, true
+	}
+
+	if locale != nil {
+		if canonical, ok := locale.UnitWords[strings.ToLower(rawUnit)]; ok {
+			if multiplier, ok := unitValueMultipliers[canonical]; ok {
+				return multiplier, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
 // HumanDate returns a contextual date string.
-// The optional reference parameter is used for comparison to determine relative output.
-func HumanDate(timestamp int64, reference ...int64) string {
+// The optional WithReference is used for comparison to determine relative output.
+//
+// BREAKING: HumanDate previously took its reference as a bare trailing
+// "reference ...int64" argument; callers must now pass WithReference(ts)
+// instead. See CHANGELOG.md.
+func HumanDate(timestamp int64, opts ...HumanDateOption) string {
+	cfg := &config{locale: LocaleEN, location: time.UTC, weekStart: time.Monday}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	locale := cfg.locale
+
 	ref := timestamp
-	if len(reference) > 0 {
-		ref = reference[0]
+	if cfg.hasReference {
+		ref = cfg.reference
 	}
 
-	// Convert to UTC time objects
-	tsTime := time.Unix(timestamp, 0).UTC()
-	refTime := time.Unix(ref, 0).UTC()
+	// Convert to the configured location so calendar-day comparison
+	// matches how a user in that zone would see it.
+	tsTime := time.Unix(timestamp, 0).In(cfg.location)
+	refTime := time.Unix(ref, 0).In(cfg.location)
 
-	// Truncate to UTC midnight for calendar day comparison
-	tsDay := time.Date(tsTime.Year(), tsTime.Month(), tsTime.Day(), 0, 0, 0, 0, time.UTC)
-	refDay := time.Date(refTime.Year(), refTime.Month(), refTime.Day(), 0, 0, 0, 0, time.UTC)
+	// Truncate to local midnight for calendar day comparison
+	tsDay := time.Date(tsTime.Year(), tsTime.Month(), tsTime.Day(), 0, 0, 0, 0, cfg.location)
+	refDay := time.Date(refTime.Year(), refTime.Month(), refTime.Day(), 0, 0, 0, 0, cfg.location)
 
 	// Calculate day difference
 	dayDiff := int(tsDay.Sub(refDay).Hours() / 24)
 
+	// Calculate calendar week difference, pivoting on cfg.weekStart, to
+	// decide "This <Weekday>" vs "Last <Weekday>".
+	tsWeekStart := startOfWeek(tsDay, cfg.weekStart)
+	refWeekStart := startOfWeek(refDay, cfg.weekStart)
+	weekDiff := int(tsWeekStart.Sub(refWeekStart).Hours() / 24 / 7)
+
 	switch {
 	case dayDiff == 0:
-		return "Today"
+		return locale.Today
 	case dayDiff == -1:
-		return "Yesterday"
+		return locale.Yesterday
 	case dayDiff == 1:
-		return "Tomorrow"
-	case dayDiff >= -6 && dayDiff <= -2:
-		return "Last " + tsTime.Weekday().String()
-	case dayDiff >= 2 && dayDiff <= 6:
-		return "This " + tsTime.Weekday().String()
+		return locale.Tomorrow
+	case weekDiff == -1:
+		return locale.LastPrefix + locale.Weekday(tsTime.Weekday())
+	case weekDiff == 0:
+		return locale.ThisPrefix + locale.Weekday(tsTime.Weekday())
 	default:
 		// Format as date
+		if cfg.dateFormat != "" {
+			return tsTime.Format(cfg.dateFormat)
+		}
 		if tsTime.Year() == refTime.Year() {
 			return tsTime.Format("January 2")
 		}
@@ -380,7 +551,80 @@ func HumanDate(timestamp int64, reference ...int64) string {
 	}
 }
 
-// DateRange formats a date range with smart abbreviation.
-func DateRange(start, end int64) string {
-	return ""
+// startOfWeek returns the midnight (in day's location) of the start of
+// day's calendar week, where weekStart is the first day of the week.
+func startOfWeek(day time.Time, weekStart time.Weekday) time.Time {
+	offset := int(day.Weekday() - weekStart)
+	if offset < 0 {
+		offset += 7
+	}
+	return day.AddDate(0, 0, -offset)
+}
+
+// DateRangeOption configures DateRange output.
+type DateRangeOption func(*dateRangeConfig)
+
+// dateRangeConfig holds options for DateRange formatting.
+type dateRangeConfig struct {
+	separator  string
+	yearAlways bool
+}
+
+// WithRangeSeparator overrides the default en dash ("–") used between the
+// start and end of the range.
+func WithRangeSeparator(sep string) DateRangeOption {
+	return func(c *dateRangeConfig) {
+		c.separator = sep
+	}
+}
+
+// WithRangeYearAlways forces ISO-ish "2006-01-02 – 2006-01-05" output,
+// including the year, instead of the shortest unambiguous form.
+func WithRangeYearAlways() DateRangeOption {
+	return func(c *dateRangeConfig) {
+		c.yearAlways = true
+	}
+}
+
+// DateRange formats a date range with smart abbreviation: the shortest
+// unambiguous string given the relationship between start and end. A
+// reversed start/end pair is normalized before formatting.
+func DateRange(start, end int64, opts ...DateRangeOption) string {
+	cfg := &dateRangeConfig{separator: "–"}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	if start > end {
+		start, end = end, start
+	}
+
+	startTime := time.Unix(start, 0).UTC()
+	endTime := time.Unix(end, 0).UTC()
+
+	// Padded is the separator with exactly one space on each side, whether
+	// or not cfg.separator brought its own (the default "–" doesn't; a
+	// custom separator like " to " already does). Used by every branch
+	// except sameMonth, which glues the separator directly for the tight
+	// "5–20" look and expects callers to supply spacing if they want it.
+	padded := " " + strings.TrimSpace(cfg.separator) + " "
+
+	if cfg.yearAlways {
+		return startTime.Format("2006-01-02") + padded + endTime.Format("2006-01-02")
+	}
+
+	sameYear := startTime.Year() == endTime.Year()
+	sameMonth := sameYear && startTime.Month() == endTime.Month()
+	sameDay := sameMonth && startTime.Day() == endTime.Day()
+
+	switch {
+	case sameDay:
+		return startTime.Format("January 2, 2006")
+	case sameMonth:
+		return startTime.Format("January 2") + cfg.separator + endTime.Format("2, 2006")
+	case sameYear:
+		return startTime.Format("January 2") + padded + endTime.Format("January 2, 2006")
+	default:
+		return startTime.Format("January 2, 2006") + padded + endTime.Format("January 2, 2006")
+	}
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -51,7 +52,7 @@ func TestTimeago(t *testing.T) {
 
 	for _, tc := range suite.TimeAgo {
 		t.Run(tc.Name, func(t *testing.T) {
-			got := TimeAgo(tc.Input.Timestamp, tc.Input.Reference)
+			got := TimeAgo(tc.Input.Timestamp, WithReference(tc.Input.Reference))
 			if got != tc.Output {
 				t.Errorf("TimeAgo(%d, %d) = %q, want %q",
 					tc.Input.Timestamp, tc.Input.Reference, got, tc.Output)
@@ -60,54 +61,235 @@ func TestTimeago(t *testing.T) {
 	}
 }
 
-// TestDurationStub verifies Duration function exists and returns empty string (stub).
-func TestDurationStub(t *testing.T) {
-	result := Duration(0)
-	if result != "" {
-		t.Errorf("Duration stub should return empty string, got %q", result)
+// TestWithReference verifies the WithReference option exists and returns a
+// non-nil Option.
+func TestWithReference(t *testing.T) {
+	opt := WithReference(0)
+	if opt == nil {
+		t.Error("WithReference(0) should return a non-nil Option")
 	}
 }
 
-// TestDurationWithOptionsStub verifies Duration accepts options.
-func TestDurationWithOptionsStub(t *testing.T) {
-	result := Duration(0, WithCompact(), WithMaxUnits(1))
-	if result != "" {
-		t.Errorf("Duration stub with options should return empty string, got %q", result)
+// TestWithLocale verifies the WithLocale option exists and is honored by
+// TimeAgo, Duration, and HumanDate.
+func TestWithLocale(t *testing.T) {
+	opt := WithLocale(LocaleNL)
+	if opt == nil {
+		t.Error("WithLocale(LocaleNL) should return a non-nil Option")
+	}
+
+	if got, want := TimeAgo(0, WithReference(30), WithLocale(LocaleNL)), "zojuist"; got != want {
+		t.Errorf("TimeAgo with LocaleNL = %q, want %q", got, want)
 	}
-}
 
-// TestParseDurationStub verifies ParseDuration exists and returns (0, nil) stub.
-func TestParseDurationStub(t *testing.T) {
-	result, err := ParseDuration("1h")
-	if result != 0 {
-		t.Errorf("ParseDuration stub should return 0, got %d", result)
+	if got, want := TimeAgo(0, WithReference(120), WithLocale(LocaleNL)), "2 minuten geleden"; got != want {
+		t.Errorf("TimeAgo with LocaleNL = %q, want %q", got, want)
 	}
+
+	if got, want := TimeAgo(0, WithReference(60), WithLocale(LocaleNL)), "1 minuut geleden"; got != want {
+		t.Errorf("TimeAgo with LocaleNL = %q, want %q", got, want)
+	}
+
+	d, err := Duration(90, WithLocale(LocaleNL))
 	if err != nil {
-		t.Errorf("ParseDuration stub should return nil error, got %v", err)
+		t.Fatalf("Duration with LocaleNL returned error: %v", err)
+	}
+	if want := "1 minuut, 30 seconden"; d != want {
+		t.Errorf("Duration with LocaleNL = %q, want %q", d, want)
+	}
+
+	if got, want := HumanDate(0, WithReference(0), WithLocale(LocaleNL)), "Vandaag"; got != want {
+		t.Errorf("HumanDate with LocaleNL = %q, want %q", got, want)
+	}
+
+	const lastWedTs = 1704888000  // Wednesday 2024-01-10
+	const lastWedRef = 1705492800 // Wednesday 2024-01-17
+	if got, want := HumanDate(lastWedTs, WithReference(lastWedRef), WithLocale(LocaleNL)), "Vorige woensdag"; got != want {
+		t.Errorf("HumanDate with LocaleNL = %q, want %q", got, want)
 	}
 }
 
-// TestHumanDateStub verifies HumanDate exists and returns empty string (stub).
-func TestHumanDateStub(t *testing.T) {
-	result := HumanDate(0)
-	if result != "" {
-		t.Errorf("HumanDate stub should return empty string, got %q", result)
+// TestParseDuration tests ParseDuration using YAML test data.
+func TestParseDuration(t *testing.T) {
+	suite := loadTestCases()
+
+	for _, tc := range suite.ParseDuration {
+		t.Run(tc.Name, func(t *testing.T) {
+			got, err := ParseDuration(tc.Input)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("ParseDuration(%q) = %d, nil; want error", tc.Input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDuration(%q) returned error: %v", tc.Input, err)
+			}
+			if got != tc.Output {
+				t.Errorf("ParseDuration(%q) = %d, want %d", tc.Input, got, tc.Output)
+			}
+		})
 	}
 }
 
-// TestHumanDateWithReferenceStub verifies HumanDate accepts optional reference.
-func TestHumanDateWithReferenceStub(t *testing.T) {
-	result := HumanDate(0, 0)
-	if result != "" {
-		t.Errorf("HumanDate stub with reference should return empty string, got %q", result)
+// TestParseDurationPreciseSubSecond verifies ParseDurationPrecise keeps
+// sub-second precision for ns/us/ms units, which ParseDuration's
+// second-granularity return value can't represent.
+func TestParseDurationPreciseSubSecond(t *testing.T) {
+	tests := []struct {
+		input string
+		want  time.Duration
+	}{
+		{"500ns", 500 * time.Nanosecond},
+		{"250us", 250 * time.Microsecond},
+		{"250µs", 250 * time.Microsecond},
+		{"1500ms", 1500 * time.Millisecond},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ParseDurationPrecise(tc.input)
+			if err != nil {
+				t.Fatalf("ParseDurationPrecise(%q) returned error: %v", tc.input, err)
+			}
+			if got != tc.want {
+				t.Errorf("ParseDurationPrecise(%q) = %v, want %v", tc.input, got, tc.want)
+			}
+		})
 	}
 }
 
-// TestDateRangeStub verifies DateRange exists and returns empty string (stub).
-func TestDateRangeStub(t *testing.T) {
-	result := DateRange(0, 0)
-	if result != "" {
-		t.Errorf("DateRange stub should return empty string, got %q", result)
+// TestParseDurationMonthYear verifies the single-letter M/Y unit tokens
+// parse as months/years without colliding with "m" (minute).
+func TestParseDurationMonthYear(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int64
+	}{
+		{"1M", secondsPerMonth},
+		{"1Y", secondsPerYear},
+		{"1y", secondsPerYear},
+		{"2 months", 2 * secondsPerMonth},
+		{"1 year", secondsPerYear},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ParseDuration(tc.input)
+			if err != nil {
+				t.Fatalf("ParseDuration(%q) returned error: %v", tc.input, err)
+			}
+			if got != tc.want {
+				t.Errorf("ParseDuration(%q) = %d, want %d", tc.input, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestHumanDate tests HumanDate function using YAML test data.
+func TestHumanDate(t *testing.T) {
+	suite := loadTestCases()
+
+	for _, tc := range suite.HumanDate {
+		t.Run(tc.Name, func(t *testing.T) {
+			opts := []HumanDateOption{WithReference(tc.Input.Reference)}
+			if tc.Input.Zone != "" {
+				loc, err := time.LoadLocation(tc.Input.Zone)
+				if err != nil {
+					t.Fatalf("failed to load zone %q: %v", tc.Input.Zone, err)
+				}
+				opts = append(opts, WithLocation(loc))
+			}
+
+			got := HumanDate(tc.Input.Timestamp, opts...)
+			if got != tc.Output {
+				t.Errorf("HumanDate(%d, %d) = %q, want %q",
+					tc.Input.Timestamp, tc.Input.Reference, got, tc.Output)
+			}
+		})
+	}
+}
+
+// TestWithWeekStart verifies WithWeekStart shifts the "This <Weekday>"
+// pivot: Wednesday 2024-01-17 falls in the same calendar week as reference
+// Sunday 2024-01-14 when the week starts on Sunday, but in the following
+// calendar week when it starts on Monday.
+func TestWithWeekStart(t *testing.T) {
+	const ts = 1705449600  // Wednesday 2024-01-17
+	const ref = 1705190400 // Sunday 2024-01-14
+
+	if got, want := HumanDate(ts, WithReference(ref), WithWeekStart(time.Sunday)), "This Wednesday"; got != want {
+		t.Errorf("HumanDate with WithWeekStart(Sunday) = %q, want %q", got, want)
+	}
+	if got, want := HumanDate(ts, WithReference(ref), WithWeekStart(time.Monday)), "January 17"; got != want {
+		t.Errorf("HumanDate with WithWeekStart(Monday) = %q, want %q", got, want)
+	}
+}
+
+// TestWithDateFormat verifies WithDateFormat overrides HumanDate's fallback
+// layout. The reference is set far enough from the timestamp (six weeks) to
+// land past the Today/Yesterday/Tomorrow and This/Last <Weekday> cases, onto
+// the fallback-format branch WithDateFormat controls.
+func TestWithDateFormat(t *testing.T) {
+	const ts = 1705320000  // 2024-01-15
+	const ref = 1709294400 // 2024-03-01
+
+	got := HumanDate(ts, WithReference(ref), WithDateFormat("2006-01-02"))
+	want := "2024-01-15"
+	if got != want {
+		t.Errorf("HumanDate with WithDateFormat(\"2006-01-02\") = %q, want %q", got, want)
+	}
+}
+
+// TestDateRange tests DateRange function using YAML test data.
+func TestDateRange(t *testing.T) {
+	suite := loadTestCases()
+
+	for _, tc := range suite.DateRange {
+		t.Run(tc.Name, func(t *testing.T) {
+			got := DateRange(tc.Input.Start, tc.Input.End)
+			if got != tc.Output {
+				t.Errorf("DateRange(%d, %d) = %q, want %q",
+					tc.Input.Start, tc.Input.End, got, tc.Output)
+			}
+		})
+	}
+}
+
+// TestWithRangeSeparator verifies the WithRangeSeparator option exists and
+// returns a DateRangeOption.
+func TestWithRangeSeparator(t *testing.T) {
+	opt := WithRangeSeparator("to")
+	if opt == nil {
+		t.Error("WithRangeSeparator(\"to\") should return a non-nil DateRangeOption")
+	}
+}
+
+// TestWithRangeSeparatorSpacing verifies a custom separator that already
+// carries its own spacing (e.g. " to ") isn't double-padded in the
+// cross-month/cross-year branches, which otherwise add explicit spaces
+// around a bare separator like the default "–".
+func TestWithRangeSeparatorSpacing(t *testing.T) {
+	const sameMonthStart = 1704412800  // 2024-01-05
+	const sameMonthEnd = 1705708800    // 2024-01-20
+	const crossMonthStart = 1704412800 // 2024-01-05
+	const crossMonthEnd = 1707523200   // 2024-02-10
+
+	if got, want := DateRange(sameMonthStart, sameMonthEnd, WithRangeSeparator(" to ")), "January 5 to 20, 2024"; got != want {
+		t.Errorf("DateRange same-month with WithRangeSeparator(\" to \") = %q, want %q", got, want)
+	}
+	if got, want := DateRange(crossMonthStart, crossMonthEnd, WithRangeSeparator(" to ")), "January 5 to February 10, 2024"; got != want {
+		t.Errorf("DateRange cross-month with WithRangeSeparator(\" to \") = %q, want %q", got, want)
+	}
+}
+
+// TestWithRangeYearAlways verifies the WithRangeYearAlways option produces
+// ISO-ish output with the year always present.
+func TestWithRangeYearAlways(t *testing.T) {
+	got := DateRange(1704412800, 1705708800, WithRangeYearAlways())
+	want := "2024-01-05 – 2024-01-20"
+	if got != want {
+		t.Errorf("DateRange with WithRangeYearAlways() = %q, want %q", got, want)
 	}
 }
 
@@ -178,8 +360,9 @@ type ParseDurationTest struct {
 
 // HumanDateInput represents input for human_date tests.
 type HumanDateInput struct {
-	Timestamp int64 `yaml:"timestamp"`
-	Reference int64 `yaml:"reference"`
+	Timestamp int64  `yaml:"timestamp"`
+	Reference int64  `yaml:"reference"`
+	Zone      string `yaml:"zone"` // optional IANA zone name, e.g. "America/Los_Angeles"
 }
 
 // HumanDateTest represents a single human_date test case.